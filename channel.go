@@ -0,0 +1,244 @@
+package slice
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+)
+
+// This file adapts SliceIterator to and from Go channels. Because channels
+// are unbuffered (or bounded) by default, every sink and source here applies
+// the same backpressure a plain channel would: a slow receiver stalls the
+// goroutine feeding it, and that stall propagates back through any iterator
+// pipeline pulling from the channel. The *Context variants exist so a
+// caller can bound how long a background goroutine will block on a stalled
+// channel, rather than leaking it for the lifetime of the process.
+
+// ToChannel consumes s on a new goroutine and returns a channel of its
+// values, closed once s is exhausted.
+func ToChannel[T any](s SliceIterator[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range s {
+			out <- v
+		}
+	}()
+	return out
+}
+
+// ToChannelContext is like ToChannel but stops feeding the channel once ctx
+// is done, so the background goroutine does not leak if the consumer
+// abandons the returned channel.
+func ToChannelContext[T any](ctx context.Context, s SliceIterator[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range s {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FromChannel returns a SliceIterator over the values received from ch,
+// stopping once ch is closed or the consumer stops early.
+func FromChannel[T any](ch <-chan T) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DispatchStrategy chooses, for each value pulled from an iterator, which
+// index into a fixed slice of output channels it should be routed to.
+// Strategies that need to weigh channels against each other (DispatchLeast,
+// DispatchMost) read the channels' current buffered length with len, which
+// is valid on a channel of any direction.
+type DispatchStrategy[T any] func(v T, outs []chan<- T) int
+
+// DispatchRoundRobin cycles through outs in order.
+func DispatchRoundRobin[T any]() DispatchStrategy[T] {
+	next := 0
+	return func(v T, outs []chan<- T) int {
+		i := next % len(outs)
+		next++
+		return i
+	}
+}
+
+// DispatchRandom picks a uniformly random channel from outs.
+func DispatchRandom[T any]() DispatchStrategy[T] {
+	return func(v T, outs []chan<- T) int {
+		return rand.Intn(len(outs))
+	}
+}
+
+// DispatchWeightedRandom picks a channel at random, biased by weights, which
+// must be the same length as outs.
+func DispatchWeightedRandom[T any](weights []int) DispatchStrategy[T] {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	return func(v T, outs []chan<- T) int {
+		if total <= 0 {
+			return rand.Intn(len(outs))
+		}
+		r := rand.Intn(total)
+		for i, w := range weights {
+			if r < w {
+				return i
+			}
+			r -= w
+		}
+		return len(outs) - 1
+	}
+}
+
+// DispatchFirstNonFull routes to the first channel in outs whose buffer is
+// not full, falling back to outs[0] if every channel is full.
+func DispatchFirstNonFull[T any]() DispatchStrategy[T] {
+	return func(v T, outs []chan<- T) int {
+		for i, ch := range outs {
+			if len(ch) < cap(ch) {
+				return i
+			}
+		}
+		return 0
+	}
+}
+
+// DispatchLeast routes to the channel with the shortest buffered length.
+func DispatchLeast[T any]() DispatchStrategy[T] {
+	return func(v T, outs []chan<- T) int {
+		best := 0
+		for i, ch := range outs {
+			if len(ch) < len(outs[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+}
+
+// DispatchMost routes to the channel with the longest buffered length.
+func DispatchMost[T any]() DispatchStrategy[T] {
+	return func(v T, outs []chan<- T) int {
+		best := 0
+		for i, ch := range outs {
+			if len(ch) > len(outs[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+}
+
+// DispatchByHash routes deterministically based on hash(v), so repeated
+// values always land on the same output channel.
+func DispatchByHash[T any](hash func(v T) uint64) DispatchStrategy[T] {
+	return func(v T, outs []chan<- T) int {
+		return int(hash(v) % uint64(len(outs)))
+	}
+}
+
+// Dispatch pulls values from it and routes each to one of outs according to
+// strategy, blocking on the chosen channel's send. Dispatch does not close
+// any of outs, since ownership of closing a shared output channel belongs
+// to whoever else may still be sending to it.
+func Dispatch[T any](it SliceIterator[T], outs []chan<- T, strategy DispatchStrategy[T]) {
+	for v := range it {
+		i := strategy(v, outs)
+		outs[i] <- v
+	}
+}
+
+// DispatchContext is like Dispatch but stops early once ctx is done, rather
+// than blocking forever on a full output channel.
+func DispatchContext[T any](ctx context.Context, it SliceIterator[T], outs []chan<- T, strategy DispatchStrategy[T]) {
+	for v := range it {
+		i := strategy(v, outs)
+		select {
+		case outs[i] <- v:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// FanIn merges chans into a single SliceIterator. The returned iterator is
+// exhausted once every channel in chans has been closed. If the consumer
+// stops early, the goroutines forwarding the remaining channels block on
+// send indefinitely; use FanInContext to bound that.
+func FanIn[T any](chans ...<-chan T) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		out := make(chan T)
+		var wg sync.WaitGroup
+		wg.Add(len(chans))
+		for _, ch := range chans {
+			go func(ch <-chan T) {
+				defer wg.Done()
+				for v := range ch {
+					out <- v
+				}
+			}(ch)
+		}
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+		for v := range out {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FanInContext is like FanIn but stops forwarding once ctx is done, so the
+// per-channel goroutines do not leak if the consumer abandons the merged
+// iterator early.
+func FanInContext[T any](ctx context.Context, chans ...<-chan T) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		out := make(chan T)
+		var wg sync.WaitGroup
+		wg.Add(len(chans))
+		for _, ch := range chans {
+			go func(ch <-chan T) {
+				defer wg.Done()
+				for {
+					select {
+					case v, ok := <-ch:
+						if !ok {
+							return
+						}
+						select {
+						case out <- v:
+						case <-ctx.Done():
+							return
+						}
+					case <-ctx.Done():
+						return
+					}
+				}
+			}(ch)
+		}
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+		for v := range out {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package slice
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestToChannelFromChannel(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+
+	ch := ToChannel(NewIterator(data))
+	it := FromChannel(ch)
+
+	// it is backed by a channel and can only be pulled once, so drain it
+	// directly rather than via Collect (which iterates twice internally).
+	var got []int
+	for v := range it {
+		got = append(got, v)
+	}
+
+	assertSlicesEqual(t, data, got, "ToChannel/FromChannel round trip failed")
+}
+
+func TestToChannelContextCancellation(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChannelContext(ctx, NewIterator(data))
+
+	first := <-ch
+	if first != 0 {
+		t.Fatalf("expected first value 0, got %d", first)
+	}
+	cancel()
+
+	// Draining after cancellation should terminate instead of hanging.
+	for range ch {
+	}
+}
+
+func TestDispatchRoundRobin(t *testing.T) {
+	data := []int{0, 1, 2, 3, 4, 5}
+
+	c0 := make(chan int, len(data))
+	c1 := make(chan int, len(data))
+	outs := []chan<- int{c0, c1}
+
+	Dispatch(NewIterator(data), outs, DispatchRoundRobin[int]())
+	close(c0)
+	close(c1)
+
+	var got0, got1 []int
+	for v := range c0 {
+		got0 = append(got0, v)
+	}
+	for v := range c1 {
+		got1 = append(got1, v)
+	}
+
+	assertSlicesEqual(t, []int{0, 2, 4}, got0, "round robin channel 0 mismatch")
+	assertSlicesEqual(t, []int{1, 3, 5}, got1, "round robin channel 1 mismatch")
+}
+
+func TestDispatchByHash(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6}
+
+	c0 := make(chan int, len(data))
+	c1 := make(chan int, len(data))
+	outs := []chan<- int{c0, c1}
+
+	Dispatch(NewIterator(data), outs, DispatchByHash(func(v int) uint64 { return uint64(v % 2) }))
+	close(c0)
+	close(c1)
+
+	var got0, got1 []int
+	for v := range c0 {
+		got0 = append(got0, v)
+	}
+	for v := range c1 {
+		got1 = append(got1, v)
+	}
+
+	assertSlicesEqual(t, []int{2, 4, 6}, got0, "hash dispatch channel 0 mismatch")
+	assertSlicesEqual(t, []int{1, 3, 5}, got1, "hash dispatch channel 1 mismatch")
+}
+
+func TestDispatchContextCancellation(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+
+	blocked := make(chan int) // unbuffered and never drained, so sends block
+	outs := []chan<- int{blocked}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	done := make(chan struct{})
+	go func() {
+		DispatchContext(ctx, NewIterator(data), outs, DispatchRoundRobin[int]())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("DispatchContext did not stop after context cancellation")
+	}
+}
+
+func TestFanIn(t *testing.T) {
+	c1 := make(chan int, 3)
+	c2 := make(chan int, 3)
+
+	for _, v := range []int{1, 2, 3} {
+		c1 <- v
+	}
+	for _, v := range []int{4, 5, 6} {
+		c2 <- v
+	}
+	close(c1)
+	close(c2)
+
+	it := FanIn[int](c1, c2)
+
+	// it is backed by channels and can only be pulled once, so drain it
+	// directly rather than via Collect (which iterates twice internally).
+	var got []int
+	for v := range it {
+		got = append(got, v)
+	}
+	sort.Ints(got)
+
+	assertSlicesEqual(t, []int{1, 2, 3, 4, 5, 6}, got, "FanIn failed")
+}
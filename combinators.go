@@ -0,0 +1,306 @@
+package slice
+
+import (
+	"cmp"
+)
+
+// Uniq returns a SliceIterator over s with duplicate elements removed,
+// keeping only the first occurrence of each value. It streams with a
+// seen-set rather than buffering the whole sequence.
+func Uniq[T comparable](s SliceIterator[T]) SliceIterator[T] {
+	return UniqBy(s, func(t T) T { return t })
+}
+
+// UniqBy is like Uniq but determines uniqueness from a key derived by key,
+// so T itself need not be comparable.
+func UniqBy[T any, K comparable](s SliceIterator[T], key func(t T) K) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		seen := make(map[K]struct{})
+		for v := range s {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Distinct streams the elements of s with a seen-set, yielding each distinct
+// value exactly once. It is equivalent to Uniq.
+func Distinct[T comparable](s SliceIterator[T]) SliceIterator[T] {
+	return Uniq(s)
+}
+
+// Chunk splits s into consecutive, non-overlapping slices of length n. The
+// final chunk may be shorter than n if s does not divide evenly. Chunk
+// panics if n is not positive.
+func Chunk[T any](s SliceIterator[T], n int) SliceIterator[[]T] {
+	if n <= 0 {
+		panic("slice: Chunk: n must be positive")
+	}
+	return func(yield func(v []T) bool) {
+		buf := make([]T, 0, n)
+		for v := range s {
+			buf = append(buf, v)
+			if len(buf) == n {
+				if !yield(buf) {
+					return
+				}
+				buf = make([]T, 0, n)
+			}
+		}
+		if len(buf) > 0 {
+			yield(buf)
+		}
+	}
+}
+
+// Windowed yields sliding windows of length size over s, advancing by step
+// elements between windows. Each yielded slice is a fresh copy. Windowed
+// panics if size or step is not positive.
+func Windowed[T any](s SliceIterator[T], size, step int) SliceIterator[[]T] {
+	if size <= 0 || step <= 0 {
+		panic("slice: Windowed: size and step must be positive")
+	}
+	return func(yield func(v []T) bool) {
+		var buf []T
+		skip := 0
+		for v := range s {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			buf = append(buf, v)
+			if len(buf) == size {
+				window := make([]T, size)
+				copy(window, buf)
+				if !yield(window) {
+					return
+				}
+				if step >= size {
+					skip = step - size
+					buf = buf[:0]
+				} else {
+					buf = buf[step:]
+				}
+			}
+		}
+	}
+}
+
+// Take returns a SliceIterator over at most the first n elements of s.
+func Take[T any](s SliceIterator[T], n int) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+			count++
+			if count == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a SliceIterator over the elements of s after dropping the
+// first n.
+func Skip[T any](s SliceIterator[T], n int) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		count := 0
+		for v := range s {
+			if count < n {
+				count++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields elements of s until pred first returns false, then stops.
+func TakeWhile[T any](s SliceIterator[T], pred func(t T) bool) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		for v := range s {
+			if !pred(v) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SkipWhile drops elements of s while pred returns true, then yields every
+// element from the first failure onward.
+func SkipWhile[T any](s SliceIterator[T], pred func(t T) bool) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		skipping := true
+		for v := range s {
+			if skipping {
+				if pred(v) {
+					continue
+				}
+				skipping = false
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Partition splits s into two SliceIterators: one over elements for which
+// pred returns true, and one over the rest. Partition makes one full pass
+// over s up front, so s is pulled only once and both returned iterators are
+// backed by already-materialized slices.
+func Partition[T any](s SliceIterator[T], pred func(t T) bool) (matched, unmatched SliceIterator[T]) {
+	var yes, no []T
+	for v := range s {
+		if pred(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return NewIterator(yes), NewIterator(no)
+}
+
+// GroupBy consumes s and returns a map from each key produced by key to the
+// elements that produced it, in encounter order.
+func GroupBy[T any, K comparable](s SliceIterator[T], key func(t T) K) map[K][]T {
+	out := make(map[K][]T)
+	for v := range s {
+		k := key(v)
+		out[k] = append(out[k], v)
+	}
+	return out
+}
+
+// FindDuplicates consumes s and returns the elements that occur more than
+// once, each reported a single time in the order it was first seen.
+func FindDuplicates[T comparable](s SliceIterator[T]) []T {
+	counts := make(map[T]int)
+	var order []T
+	for v := range s {
+		if counts[v] == 0 {
+			order = append(order, v)
+		}
+		counts[v]++
+	}
+	var dups []T
+	for _, v := range order {
+		if counts[v] > 1 {
+			dups = append(dups, v)
+		}
+	}
+	return dups
+}
+
+// Min returns the smallest element of s and true, or the zero value and
+// false if s is empty.
+func Min[T cmp.Ordered](s SliceIterator[T]) (T, bool) {
+	var min T
+	found := false
+	for v := range s {
+		if !found || v < min {
+			min = v
+			found = true
+		}
+	}
+	return min, found
+}
+
+// Max returns the largest element of s and true, or the zero value and
+// false if s is empty.
+func Max[T cmp.Ordered](s SliceIterator[T]) (T, bool) {
+	var max T
+	found := false
+	for v := range s {
+		if !found || v > max {
+			max = v
+			found = true
+		}
+	}
+	return max, found
+}
+
+// MinBy is like Min but compares elements by a key derived from key rather
+// than the elements themselves.
+func MinBy[T any, K cmp.Ordered](s SliceIterator[T], key func(t T) K) (T, bool) {
+	var min T
+	var minKey K
+	found := false
+	for v := range s {
+		k := key(v)
+		if !found || k < minKey {
+			min = v
+			minKey = k
+			found = true
+		}
+	}
+	return min, found
+}
+
+// MaxBy is like Max but compares elements by a key derived from key rather
+// than the elements themselves.
+func MaxBy[T any, K cmp.Ordered](s SliceIterator[T], key func(t T) K) (T, bool) {
+	var max T
+	var maxKey K
+	found := false
+	for v := range s {
+		k := key(v)
+		if !found || k > maxKey {
+			max = v
+			maxKey = k
+			found = true
+		}
+	}
+	return max, found
+}
+
+// Number constrains the built-in numeric types accepted by Sum and Product.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all elements of s, or the zero value if s is empty.
+func Sum[T Number](s SliceIterator[T]) T {
+	var total T
+	for v := range s {
+		total += v
+	}
+	return total
+}
+
+// Product returns the product of all elements of s, or the zero value if s
+// is empty.
+func Product[T Number](s SliceIterator[T]) T {
+	var total T
+	first := true
+	for v := range s {
+		if first {
+			total = v
+			first = false
+			continue
+		}
+		total *= v
+	}
+	if first {
+		return 0
+	}
+	return total
+}
@@ -0,0 +1,250 @@
+package slice
+
+import (
+	"testing"
+)
+
+func TestUniq(t *testing.T) {
+	t.Run("RemovesDuplicates", func(t *testing.T) {
+		it := Uniq(NewIterator([]int{1, 2, 2, 3, 1, 4}))
+		assertSlicesEqual(t, []int{1, 2, 3, 4}, it.Collect(), "Uniq failed")
+	})
+
+	t.Run("EmptyIterator", func(t *testing.T) {
+		it := Uniq(NewIterator([]int{}))
+		assertSlicesEqual(t, []int{}, it.Collect(), "Uniq on empty failed")
+	})
+}
+
+func TestUniqBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := []person{{"a", 1}, {"b", 1}, {"c", 2}}
+
+	it := UniqBy(NewIterator(people), func(p person) int { return p.Age })
+	got := it.Collect()
+
+	if len(got) != 2 || got[0].Name != "a" || got[1].Name != "c" {
+		t.Fatalf("UniqBy failed: %+v", got)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	it := Distinct(NewIterator([]string{"x", "y", "x", "z"}))
+	assertSlicesEqual(t, []string{"x", "y", "z"}, it.Collect(), "Distinct failed")
+}
+
+func TestChunk(t *testing.T) {
+	t.Run("EvenChunks", func(t *testing.T) {
+		it := Chunk(NewIterator([]int{1, 2, 3, 4}), 2)
+		got := it.Collect()
+		if len(got) != 2 {
+			t.Fatalf("expected 2 chunks, got %d", len(got))
+		}
+		assertSlicesEqual(t, []int{1, 2}, got[0], "first chunk mismatch")
+		assertSlicesEqual(t, []int{3, 4}, got[1], "second chunk mismatch")
+	})
+
+	t.Run("RemainderChunk", func(t *testing.T) {
+		it := Chunk(NewIterator([]int{1, 2, 3}), 2)
+		got := it.Collect()
+		if len(got) != 2 {
+			t.Fatalf("expected 2 chunks, got %d", len(got))
+		}
+		assertSlicesEqual(t, []int{3}, got[1], "remainder chunk mismatch")
+	})
+
+	t.Run("PanicsOnNonPositiveN", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected Chunk to panic on n=0")
+			}
+		}()
+		Chunk(NewIterator([]int{1}), 0).Collect()
+	})
+}
+
+func TestWindowed(t *testing.T) {
+	t.Run("OverlappingStep", func(t *testing.T) {
+		it := Windowed(NewIterator([]int{1, 2, 3, 4, 5}), 3, 1)
+		got := it.Collect()
+
+		want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+		if len(got) != len(want) {
+			t.Fatalf("expected %d windows, got %d", len(want), len(got))
+		}
+		for i := range want {
+			assertSlicesEqual(t, want[i], got[i], "window mismatch")
+		}
+	})
+
+	t.Run("StepLargerThanSize", func(t *testing.T) {
+		it := Windowed(NewIterator([]int{1, 2, 3, 4, 5, 6, 7}), 2, 3)
+		got := it.Collect()
+
+		want := [][]int{{1, 2}, {4, 5}}
+		if len(got) != len(want) {
+			t.Fatalf("expected %d windows, got %d", len(want), len(got))
+		}
+		for i := range want {
+			assertSlicesEqual(t, want[i], got[i], "window mismatch with skipped elements")
+		}
+	})
+}
+
+func TestTake(t *testing.T) {
+	t.Run("FewerThanAvailable", func(t *testing.T) {
+		it := Take(NewIterator([]int{1, 2, 3, 4}), 2)
+		assertSlicesEqual(t, []int{1, 2}, it.Collect(), "Take failed")
+	})
+
+	t.Run("MoreThanAvailable", func(t *testing.T) {
+		it := Take(NewIterator([]int{1, 2}), 5)
+		assertSlicesEqual(t, []int{1, 2}, it.Collect(), "Take overrun failed")
+	})
+}
+
+func TestSkip(t *testing.T) {
+	it := Skip(NewIterator([]int{1, 2, 3, 4}), 2)
+	assertSlicesEqual(t, []int{3, 4}, it.Collect(), "Skip failed")
+}
+
+func TestTakeWhile(t *testing.T) {
+	it := TakeWhile(NewIterator([]int{1, 2, 3, 4, 1}), func(v int) bool { return v < 3 })
+	assertSlicesEqual(t, []int{1, 2}, it.Collect(), "TakeWhile failed")
+}
+
+func TestSkipWhile(t *testing.T) {
+	it := SkipWhile(NewIterator([]int{1, 2, 3, 4, 1}), func(v int) bool { return v < 3 })
+	assertSlicesEqual(t, []int{3, 4, 1}, it.Collect(), "SkipWhile failed")
+}
+
+func TestPartition(t *testing.T) {
+	evens, odds := Partition(NewIterator([]int{1, 2, 3, 4, 5}), func(v int) bool { return v%2 == 0 })
+	assertSlicesEqual(t, []int{2, 4}, evens.Collect(), "evens mismatch")
+	assertSlicesEqual(t, []int{1, 3, 5}, odds.Collect(), "odds mismatch")
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(NewIterator([]int{1, 2, 3, 4, 5, 6}), func(v int) int { return v % 3 })
+
+	assertSlicesEqual(t, []int{3, 6}, groups[0], "group 0 mismatch")
+	assertSlicesEqual(t, []int{1, 4}, groups[1], "group 1 mismatch")
+	assertSlicesEqual(t, []int{2, 5}, groups[2], "group 2 mismatch")
+}
+
+func TestFindDuplicates(t *testing.T) {
+	dups := FindDuplicates(NewIterator([]int{1, 2, 2, 3, 1, 4}))
+	assertSlicesEqual(t, []int{1, 2}, dups, "FindDuplicates failed")
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("NonEmpty", func(t *testing.T) {
+		min, ok := Min(NewIterator([]int{3, 1, 2}))
+		if !ok || min != 1 {
+			t.Fatalf("Min failed: got %d, ok %v", min, ok)
+		}
+		max, ok := Max(NewIterator([]int{3, 1, 2}))
+		if !ok || max != 3 {
+			t.Fatalf("Max failed: got %d, ok %v", max, ok)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if _, ok := Min(NewIterator([]int{})); ok {
+			t.Fatalf("Min should return false for empty iterator")
+		}
+		if _, ok := Max(NewIterator([]int{})); ok {
+			t.Fatalf("Max should return false for empty iterator")
+		}
+	})
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := []person{{"a", 30}, {"b", 20}, {"c", 40}}
+
+	youngest, ok := MinBy(NewIterator(people), func(p person) int { return p.Age })
+	if !ok || youngest.Name != "b" {
+		t.Fatalf("MinBy failed: %+v, ok %v", youngest, ok)
+	}
+
+	oldest, ok := MaxBy(NewIterator(people), func(p person) int { return p.Age })
+	if !ok || oldest.Name != "c" {
+		t.Fatalf("MaxBy failed: %+v, ok %v", oldest, ok)
+	}
+}
+
+func TestSumProduct(t *testing.T) {
+	t.Run("Sum", func(t *testing.T) {
+		if got := Sum(NewIterator([]int{1, 2, 3, 4})); got != 10 {
+			t.Fatalf("Sum failed: got %d", got)
+		}
+	})
+
+	t.Run("Product", func(t *testing.T) {
+		if got := Product(NewIterator([]int{1, 2, 3, 4})); got != 24 {
+			t.Fatalf("Product failed: got %d", got)
+		}
+	})
+
+	t.Run("EmptyIterators", func(t *testing.T) {
+		if got := Sum(NewIterator([]int{})); got != 0 {
+			t.Fatalf("Sum on empty should be 0, got %d", got)
+		}
+		if got := Product(NewIterator([]int{})); got != 0 {
+			t.Fatalf("Product on empty should be 0, got %d", got)
+		}
+	})
+}
+
+/*
+BENCHMARKS
+*/
+
+func BenchmarkUniq_100000000(b *testing.B) {
+	const size = 100_000_000
+	data := make([]int, size)
+	for i := range data {
+		data[i] = i % 1000
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		dataIter := NewIterator(data)
+		Uniq(dataIter)
+	}
+}
+
+func BenchmarkChunk_100000000(b *testing.B) {
+	const size = 100_000_000
+	data := make([]int, size)
+	for i := range data {
+		data[i] = i
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		dataIter := NewIterator(data)
+		Chunk(dataIter, 100)
+	}
+}
+
+func BenchmarkGroupBy_100000000(b *testing.B) {
+	const size = 100_000_000
+	data := make([]int, size)
+	for i := range data {
+		data[i] = i
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		dataIter := NewIterator(data)
+		GroupBy(dataIter, func(x int) int { return x % 10 })
+	}
+}
@@ -0,0 +1,93 @@
+package slice
+
+import (
+	"iter"
+)
+
+// PairIterator is a type definition of an iter.Seq2 for key/value pairs of
+// types K and V.
+type PairIterator[K, V any] iter.Seq2[K, V]
+
+// NewMapIterator creates a PairIterator that iterates over the key/value
+// pairs of the provided map m. As with ranging over a map directly, the
+// iteration order is unspecified.
+func NewMapIterator[K comparable, V any](m map[K]V) PairIterator[K, V] {
+	return func(yield func(k K, v V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a SliceIterator over the keys of p.
+func Keys[K, V any](p PairIterator[K, V]) SliceIterator[K] {
+	return func(yield func(k K) bool) {
+		for k := range iter.Seq2[K, V](p) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a SliceIterator over the values of p.
+func Values[K, V any](p PairIterator[K, V]) SliceIterator[V] {
+	return func(yield func(v V) bool) {
+		for _, v := range iter.Seq2[K, V](p) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Entries returns a SliceIterator of Tuples combining each key of p with its
+// value.
+func Entries[K, V any](p PairIterator[K, V]) SliceIterator[Tuple[K, V]] {
+	return func(yield func(t Tuple[K, V]) bool) {
+		for k, v := range iter.Seq2[K, V](p) {
+			if !yield(Tuple[K, V]{k, v}) {
+				return
+			}
+		}
+	}
+}
+
+// MapPairs applies a transformation function to every key/value pair of p
+// and returns a new PairIterator of the results.
+func MapPairs[K, V, K2, V2 any](p PairIterator[K, V], transform func(k K, v V) (K2, V2)) PairIterator[K2, V2] {
+	return func(yield func(k K2, v V2) bool) {
+		for k, v := range iter.Seq2[K, V](p) {
+			k2, v2 := transform(k, v)
+			if !yield(k2, v2) {
+				return
+			}
+		}
+	}
+}
+
+// FilterPairs iterates over p and returns a new PairIterator containing only
+// the pairs for which the provided filter function returns true.
+func FilterPairs[K, V any](p PairIterator[K, V], filter func(k K, v V) bool) PairIterator[K, V] {
+	return func(yield func(k K, v V) bool) {
+		for k, v := range iter.Seq2[K, V](p) {
+			if filter(k, v) {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ReducePairs combines all pairs of p into a single accumulated value R,
+// starting with an initial value.
+func ReducePairs[K, V, R any](p PairIterator[K, V], initial R, reduce func(acc R, k K, v V) R) R {
+	res := initial
+	for k, v := range iter.Seq2[K, V](p) {
+		res = reduce(res, k, v)
+	}
+	return res
+}
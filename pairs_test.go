@@ -0,0 +1,125 @@
+package slice
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestNewMapIteratorKeysValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	p := NewMapIterator(m)
+
+	keys := Keys(p).Collect()
+	sort.Strings(keys)
+	assertSlicesEqual(t, []string{"a", "b", "c"}, keys, "Keys mismatch")
+
+	values := Values(NewMapIterator(m)).Collect()
+	sort.Ints(values)
+	assertSlicesEqual(t, []int{1, 2, 3}, values, "Values mismatch")
+}
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	entries := Entries(NewMapIterator(m)).Collect()
+
+	got := make(map[string]int, len(entries))
+	for _, e := range entries {
+		got[e.Left] = e.Right
+	}
+
+	if len(got) != len(m) {
+		t.Fatalf("Entries length mismatch: got %d, want %d", len(got), len(m))
+	}
+	for k, v := range m {
+		if got[k] != v {
+			t.Fatalf("Entries[%s] = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestMapPairs(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	doubled := MapPairs(NewMapIterator(m), func(k string, v int) (string, int) {
+		return k, v * 2
+	})
+
+	got := ToMap(Entries(doubled), func(t Tuple[string, int]) string { return t.Left }, func(t Tuple[string, int]) int { return t.Right })
+
+	want := map[string]int{"a": 2, "b": 4}
+	if len(got) != len(want) {
+		t.Fatalf("MapPairs length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("MapPairs[%s] = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestFilterPairs(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+	evens := FilterPairs(NewMapIterator(m), func(k string, v int) bool { return v%2 == 0 })
+	got := ToSet(Keys(evens))
+
+	want := map[string]struct{}{"b": {}, "d": {}}
+	if len(got) != len(want) {
+		t.Fatalf("FilterPairs length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for k := range want {
+		if _, ok := got[k]; !ok {
+			t.Fatalf("FilterPairs missing key %s", k)
+		}
+	}
+}
+
+func TestReducePairs(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	sum := ReducePairs(NewMapIterator(m), 0, func(acc int, k string, v int) int {
+		return acc + v
+	})
+
+	if sum != 6 {
+		t.Fatalf("ReducePairs failed: got %d, want 6", sum)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	it := NewIterator([]string{"x", "y", "z"})
+
+	var got []Tuple[int, string]
+	for i, v := range it.Enumerate() {
+		got = append(got, Tuple[int, string]{i, v})
+	}
+
+	want := []Tuple[int, string]{{0, "x"}, {1, "y"}, {2, "z"}}
+	if len(got) != len(want) {
+		t.Fatalf("Enumerate length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Enumerate mismatch at %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestEnumerated(t *testing.T) {
+	it := NewIterator([]string{"x", "y", "z"})
+
+	var got []Enumerated[string]
+	for e := range it.Enumerated() {
+		got = append(got, e)
+	}
+
+	want := []Enumerated[string]{{0, "x"}, {1, "y"}, {2, "z"}}
+	if len(got) != len(want) {
+		t.Fatalf("Enumerated length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Enumerated mismatch at %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
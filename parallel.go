@@ -0,0 +1,247 @@
+package slice
+
+import (
+	"context"
+	"sync"
+)
+
+// ParOrder selects how ParMap and ParFilter emit results relative to the
+// order of their input.
+type ParOrder int
+
+const (
+	// OrderPreserving buffers per-index results and emits them in input
+	// order. Useful when composed downstream with Zip or Enumerate.
+	OrderPreserving ParOrder = iota
+	// Unordered yields results as soon as any worker completes them,
+	// trading input order for throughput.
+	Unordered
+)
+
+type parConfig struct {
+	order ParOrder
+	ctx   context.Context
+}
+
+// ParOption configures the parallel combinators ParMap, ParFilter and
+// ParReduce.
+type ParOption func(*parConfig)
+
+// WithOrder selects ordered or unordered emission. The default is
+// OrderPreserving.
+func WithOrder(o ParOrder) ParOption {
+	return func(c *parConfig) { c.order = o }
+}
+
+// WithContext ties a parallel combinator's cancellation to ctx: once ctx is
+// done, in-flight work stops and no further values are produced.
+func WithContext(ctx context.Context) ParOption {
+	return func(c *parConfig) { c.ctx = ctx }
+}
+
+func newParConfig(opts []ParOption) parConfig {
+	cfg := parConfig{order: OrderPreserving, ctx: context.Background()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// ParMap is like Map but applies transform using workers goroutines. By
+// default results are emitted in input order (OrderPreserving); pass
+// WithOrder(Unordered) to emit as soon as any worker finishes, or
+// WithContext to tie cancellation to an external context.Context. If the
+// downstream consumer stops early (yield returns false) or ctx is done, all
+// workers are stopped and their goroutines are allowed to drain before
+// ParMap returns. A panic inside transform is recovered from the worker,
+// propagated across the goroutine boundary, and re-panicked from the
+// iterating goroutine once all workers have stopped.
+func ParMap[T, V any](s SliceIterator[T], workers int, transform func(t T) V, opts ...ParOption) SliceIterator[V] {
+	cfg := newParConfig(opts)
+	if workers <= 0 {
+		workers = 1
+	}
+
+	return func(yield func(v V) bool) {
+		ctx, cancel := context.WithCancel(cfg.ctx)
+		defer cancel()
+
+		type indexed struct {
+			index int
+			value T
+		}
+		type result struct {
+			index int
+			value V
+		}
+
+		in := make(chan indexed)
+		out := make(chan result)
+
+		var mu sync.Mutex
+		var workerPanic any
+
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+				defer func() {
+					if r := recover(); r != nil {
+						mu.Lock()
+						if workerPanic == nil {
+							workerPanic = r
+						}
+						mu.Unlock()
+						cancel()
+					}
+				}()
+				for item := range in {
+					select {
+					case out <- result{item.index, transform(item.value)}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			defer close(in)
+			i := 0
+			for v := range s {
+				select {
+				case in <- indexed{i, v}:
+				case <-ctx.Done():
+					return
+				}
+				i++
+			}
+		}()
+
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+
+		stopped := false
+		emit := func(v V) {
+			if stopped || ctx.Err() != nil {
+				return
+			}
+			if !yield(v) {
+				stopped = true
+				cancel()
+			}
+		}
+
+		switch cfg.order {
+		case Unordered:
+			for r := range out {
+				emit(r.value)
+			}
+		default:
+			pending := make(map[int]V)
+			next := 0
+			for r := range out {
+				pending[r.index] = r.value
+				for {
+					v, ok := pending[next]
+					if !ok || stopped {
+						break
+					}
+					delete(pending, next)
+					next++
+					emit(v)
+				}
+			}
+		}
+
+		mu.Lock()
+		p := workerPanic
+		mu.Unlock()
+		if p != nil {
+			panic(p)
+		}
+	}
+}
+
+// ParFilter is like Filter but evaluates predicate using workers goroutines.
+// It accepts the same ParOptions as ParMap and shares its cancellation and
+// panic-propagation semantics.
+func ParFilter[T any](s SliceIterator[T], workers int, predicate func(t T) bool, opts ...ParOption) SliceIterator[T] {
+	type tagged struct {
+		value T
+		keep  bool
+	}
+
+	tags := ParMap(s, workers, func(t T) tagged {
+		return tagged{value: t, keep: predicate(t)}
+	}, opts...)
+
+	return func(yield func(v T) bool) {
+		for t := range tags {
+			if t.keep {
+				if !yield(t.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ParReduce performs a tree-style parallel reduction over it using workers
+// goroutines: each worker folds its share of the input into a local
+// accumulator with fold (seeded with identity), and the per-worker
+// accumulators are then combined into a single result with combine. Each
+// element goes to whichever worker is free, and the accumulators are combined
+// in the order the workers finish, so combine must be both associative and
+// commutative for the result not to depend on scheduling. ParReduce accepts
+// WithContext for cancellation.
+func ParReduce[T, V any](it SliceIterator[T], workers int, identity V, combine func(a, b V) V, fold func(acc V, v T) V, opts ...ParOption) V {
+	cfg := newParConfig(opts)
+	if workers <= 0 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(cfg.ctx)
+	defer cancel()
+
+	in := make(chan T)
+	partials := make(chan V, workers)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			acc := identity
+			for v := range in {
+				acc = fold(acc, v)
+			}
+			partials <- acc
+		}()
+	}
+
+	go func() {
+		defer close(in)
+		for v := range it {
+			select {
+			case in <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(partials)
+	}()
+
+	result := identity
+	for p := range partials {
+		result = combine(result, p)
+	}
+	return result
+}
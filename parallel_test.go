@@ -0,0 +1,146 @@
+package slice
+
+import (
+	"context"
+	"sort"
+	"testing"
+)
+
+func TestParMapOrderPreserving(t *testing.T) {
+	data := make([]int, 200)
+	for i := range data {
+		data[i] = i
+	}
+
+	it := ParMap(NewIterator(data), 8, func(v int) int { return v * v })
+	got := it.Collect()
+
+	want := make([]int, len(data))
+	for i, v := range data {
+		want[i] = v * v
+	}
+	assertSlicesEqual(t, want, got, "ParMap order-preserving failed")
+}
+
+func TestParMapUnordered(t *testing.T) {
+	data := make([]int, 200)
+	for i := range data {
+		data[i] = i
+	}
+
+	it := ParMap(NewIterator(data), 8, func(v int) int { return v * v }, WithOrder(Unordered))
+	got := it.Collect()
+
+	want := make([]int, len(data))
+	for i, v := range data {
+		want[i] = v * v
+	}
+
+	sort.Ints(got)
+	sort.Ints(want)
+	assertSlicesEqual(t, want, got, "ParMap unordered produced wrong value set")
+}
+
+func TestParMapEarlyCancellation(t *testing.T) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = i
+	}
+
+	it := ParMap(NewIterator(data), 4, func(v int) int { return v })
+
+	var got []int
+	it(func(v int) bool {
+		got = append(got, v)
+		return len(got) < 5
+	})
+
+	if len(got) != 5 {
+		t.Fatalf("expected early stop after 5 values, got %d", len(got))
+	}
+}
+
+func TestParMapContextCancellation(t *testing.T) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = i
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := ParMap(NewIterator(data), 4, func(v int) int { return v }, WithContext(ctx))
+
+	var got []int
+	for v := range it {
+		got = append(got, v)
+	}
+
+	if len(got) == len(data) {
+		t.Fatalf("expected cancellation to short-circuit iteration, got all %d values", len(got))
+	}
+}
+
+func TestParMapPanicPropagation(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic from ParMap transform to propagate")
+		}
+	}()
+
+	data := []int{1, 2, 3, 0, 5}
+	it := ParMap(NewIterator(data), 4, func(v int) int {
+		return 10 / v
+	})
+	it.Collect()
+}
+
+func TestParFilter(t *testing.T) {
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = i
+	}
+
+	it := ParFilter(NewIterator(data), 4, func(v int) bool { return v%2 == 0 })
+	got := it.Collect()
+
+	var want []int
+	for _, v := range data {
+		if v%2 == 0 {
+			want = append(want, v)
+		}
+	}
+	assertSlicesEqual(t, want, got, "ParFilter failed")
+}
+
+func TestParReduce(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i + 1
+	}
+
+	sum := ParReduce(NewIterator(data), 8, 0,
+		func(a, b int) int { return a + b },
+		func(acc int, v int) int { return acc + v },
+	)
+
+	want := 0
+	for _, v := range data {
+		want += v
+	}
+
+	if sum != want {
+		t.Fatalf("ParReduce failed: got %d, want %d", sum, want)
+	}
+}
+
+func TestParReduceEmpty(t *testing.T) {
+	sum := ParReduce(NewIterator([]int{}), 4, 0,
+		func(a, b int) int { return a + b },
+		func(acc int, v int) int { return acc + v },
+	)
+
+	if sum != 0 {
+		t.Fatalf("ParReduce on empty should be 0, got %d", sum)
+	}
+}
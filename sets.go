@@ -0,0 +1,148 @@
+package slice
+
+// Union returns a SliceIterator over the distinct elements appearing in a or
+// b, each yielded once, in the order first seen across a then b.
+func Union[T comparable](a, b SliceIterator[T]) SliceIterator[T] {
+	return UnionBy(a, b, func(t T) T { return t })
+}
+
+// UnionBy is like Union but determines identity from a key derived by key,
+// so T need not be comparable.
+func UnionBy[T any, K comparable](a, b SliceIterator[T], key func(t T) K) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		seen := make(map[K]struct{})
+		for v := range Concat(a, b) {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Intersection returns a SliceIterator over the distinct elements of a that
+// also appear in b. b is fully materialized into a set before any element of
+// a is yielded.
+func Intersection[T comparable](a, b SliceIterator[T]) SliceIterator[T] {
+	return IntersectionBy(a, b, func(t T) T { return t })
+}
+
+// IntersectionBy is like Intersection but determines identity from a key
+// derived by key, so T need not be comparable.
+func IntersectionBy[T any, K comparable](a, b SliceIterator[T], key func(t T) K) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		inB := ToSet(Map(b, key))
+		seen := make(map[K]struct{})
+		for v := range a {
+			k := key(v)
+			if _, ok := inB[k]; !ok {
+				continue
+			}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Difference returns a SliceIterator over the distinct elements of a that do
+// not appear in b. b is fully materialized into a set before any element of
+// a is yielded.
+func Difference[T comparable](a, b SliceIterator[T]) SliceIterator[T] {
+	return DifferenceBy(a, b, func(t T) T { return t })
+}
+
+// DifferenceBy is like Difference but determines identity from a key derived
+// by key, so T need not be comparable.
+func DifferenceBy[T any, K comparable](a, b SliceIterator[T], key func(t T) K) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		inB := ToSet(Map(b, key))
+		seen := make(map[K]struct{})
+		for v := range a {
+			k := key(v)
+			if _, ok := inB[k]; ok {
+				continue
+			}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SymmetricDifference returns a SliceIterator over the distinct elements
+// that appear in exactly one of a or b. a is fully materialized before any
+// element is yielded.
+func SymmetricDifference[T comparable](a, b SliceIterator[T]) SliceIterator[T] {
+	return SymmetricDifferenceBy(a, b, func(t T) T { return t })
+}
+
+// SymmetricDifferenceBy is like SymmetricDifference but determines identity
+// from a key derived by key, so T need not be comparable.
+func SymmetricDifferenceBy[T any, K comparable](a, b SliceIterator[T], key func(t T) K) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		inA := make(map[K]T)
+		for v := range a {
+			inA[key(v)] = v
+		}
+
+		matched := make(map[K]struct{})
+		seenInB := make(map[K]struct{})
+		for v := range b {
+			k := key(v)
+			if _, ok := inA[k]; ok {
+				matched[k] = struct{}{}
+				continue
+			}
+			if _, ok := seenInB[k]; ok {
+				continue
+			}
+			seenInB[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+
+		for k, v := range inA {
+			if _, ok := matched[k]; ok {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap consumes it and returns a map built from the key and value computed
+// for each element by keyFn and valFn. As with a Go map literal, later
+// elements overwrite earlier ones that produce the same key.
+func ToMap[T any, K comparable, V any](it SliceIterator[T], keyFn func(t T) K, valFn func(t T) V) map[K]V {
+	out := make(map[K]V)
+	for v := range it {
+		out[keyFn(v)] = valFn(v)
+	}
+	return out
+}
+
+// ToSet consumes it and returns the set of its distinct elements,
+// represented as a map to the empty struct.
+func ToSet[T comparable](it SliceIterator[T]) map[T]struct{} {
+	out := make(map[T]struct{})
+	for v := range it {
+		out[v] = struct{}{}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package slice
+
+import (
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	a := NewIterator([]int{1, 2, 3})
+	b := NewIterator([]int{2, 3, 4})
+
+	got := CollectSorted(Union(a, b))
+	assertSlicesEqual(t, []int{1, 2, 3, 4}, got, "Union failed")
+}
+
+func TestUnionEmpty(t *testing.T) {
+	a := NewIterator([]int{})
+	b := NewIterator([]int{})
+
+	got := Union(a, b).Collect()
+	assertSlicesEqual(t, []int{}, got, "Union of two empty iterators failed")
+}
+
+func TestIntersection(t *testing.T) {
+	a := NewIterator([]int{1, 2, 2, 3})
+	b := NewIterator([]int{2, 3, 4})
+
+	got := CollectSorted(Intersection(a, b))
+	assertSlicesEqual(t, []int{2, 3}, got, "Intersection failed")
+}
+
+func TestDifference(t *testing.T) {
+	a := NewIterator([]int{1, 2, 2, 3})
+	b := NewIterator([]int{2, 4})
+
+	got := CollectSorted(Difference(a, b))
+	assertSlicesEqual(t, []int{1, 3}, got, "Difference failed")
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	a := NewIterator([]int{1, 2, 3})
+	b := NewIterator([]int{2, 3, 4})
+
+	got := CollectSorted(SymmetricDifference(a, b))
+	assertSlicesEqual(t, []int{1, 4}, got, "SymmetricDifference failed")
+}
+
+func TestSymmetricDifferenceDuplicates(t *testing.T) {
+	a := NewIterator([]int{1, 1, 2})
+	b := NewIterator([]int{2, 2, 3, 3})
+
+	got := CollectSorted(SymmetricDifference(a, b))
+	assertSlicesEqual(t, []int{1, 3}, got, "SymmetricDifference duplicate handling failed")
+}
+
+func TestToMap(t *testing.T) {
+	it := NewIterator([]string{"a", "bb", "ccc"})
+
+	got := ToMap(it, func(s string) string { return s }, func(s string) int { return len(s) })
+
+	want := map[string]int{"a": 1, "bb": 2, "ccc": 3}
+	if len(got) != len(want) {
+		t.Fatalf("ToMap length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("ToMap[%s] = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestToSet(t *testing.T) {
+	it := NewIterator([]int{1, 2, 2, 3, 1})
+
+	got := ToSet(it)
+
+	want := map[int]struct{}{1: {}, 2: {}, 3: {}}
+	if len(got) != len(want) {
+		t.Fatalf("ToSet length mismatch: got %d, want %d", len(got), len(want))
+	}
+	for k := range want {
+		if _, ok := got[k]; !ok {
+			t.Fatalf("ToSet missing key %d", k)
+		}
+	}
+}
@@ -23,16 +23,33 @@ type Enumerated[U any] struct {
 	Index int
 	Value U
 }
+
+// Enumerator is a thin adapter over PairIterator[int, V], kept for callers
+// that prefer the Enumerated struct form over ranging with two values.
 type Enumerator[V any] iter.Seq[Enumerated[V]]
 
-func (s SliceIterator[T]) Enumerate() Enumerator[T] {
-	return func(yield func(v Enumerated[T]) bool) {
-		i := 0
+// Enumerate returns a PairIterator pairing each element's index with its
+// value, so callers can range with for i, v := range it.Enumerate().
+func (s SliceIterator[T]) Enumerate() PairIterator[int, T] {
+	return func(yield func(i int, v T) bool) {
+		idx := 0
 		for v := range s {
+			if !yield(idx, v) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// Enumerated adapts s's enumeration into the legacy Enumerator form, for
+// callers that still want an Enumerated{Index, Value} struct per element.
+func (s SliceIterator[T]) Enumerated() Enumerator[T] {
+	return func(yield func(v Enumerated[T]) bool) {
+		for i, v := range s.Enumerate() {
 			if !yield(Enumerated[T]{i, v}) {
 				return
 			}
-			i++
 		}
 	}
 }
@@ -50,8 +67,8 @@ func (s SliceIterator[T]) Count() int {
 // the yielded elements.
 func (i SliceIterator[T]) Collect() []T {
 	out := make([]T, i.Count())
-	for e := range i.Enumerate() {
-		out[e.Index] = e.Value
+	for idx, v := range i.Enumerate() {
+		out[idx] = v
 	}
 	return out
 }
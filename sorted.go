@@ -0,0 +1,23 @@
+package slice
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CollectSorted consumes it and returns its elements sorted in ascending
+// order.
+func CollectSorted[T cmp.Ordered](it SliceIterator[T]) []T {
+	out := it.Collect()
+	slices.Sort(out)
+	return out
+}
+
+// CollectSortedFunc is like CollectSorted but orders elements using less,
+// which follows the same contract as slices.SortFunc: less(a, b) is
+// negative, zero, or positive as a orders before, equal to, or after b.
+func CollectSortedFunc[T any](it SliceIterator[T], less func(a, b T) int) []T {
+	out := it.Collect()
+	slices.SortFunc(out, less)
+	return out
+}
@@ -0,0 +1,36 @@
+package slice
+
+import (
+	"testing"
+)
+
+func TestCollectSorted(t *testing.T) {
+	t.Run("UnsortedInts", func(t *testing.T) {
+		got := CollectSorted(NewIterator([]int{3, 1, 4, 1, 5, 9, 2, 6}))
+		assertSlicesEqual(t, []int{1, 1, 2, 3, 4, 5, 6, 9}, got, "CollectSorted failed")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		got := CollectSorted(NewIterator([]int{}))
+		assertSlicesEqual(t, []int{}, got, "CollectSorted on empty failed")
+	})
+}
+
+func TestCollectSortedFunc(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := []person{{"b", 30}, {"a", 20}, {"c", 25}}
+
+	got := CollectSortedFunc(NewIterator(people), func(a, b person) int {
+		return a.Age - b.Age
+	})
+
+	want := []string{"a", "c", "b"}
+	for i, p := range got {
+		if p.Name != want[i] {
+			t.Fatalf("CollectSortedFunc order mismatch at %d: got %s, want %s", i, p.Name, want[i])
+		}
+	}
+}
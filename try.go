@@ -0,0 +1,132 @@
+package slice
+
+import (
+	"fmt"
+	"iter"
+)
+
+// TryIterator is a type definition of an iter.Seq2 that pairs each yielded
+// value with an error. A non-nil error is terminal: once one has been
+// yielded, the producer will not yield any further values.
+type TryIterator[T any] iter.Seq2[T, error]
+
+// Safe wraps s so that the returned SliceIterator tolerates a misbehaving
+// producer. It guarantees the pull side sees at most one yield=false: once
+// the caller's yield returns false, further calls from s become no-ops
+// instead of resuming iteration. It also recovers a panic raised inside s,
+// treating it as early termination rather than letting it escape to the
+// caller. This lets untrusted iterators (e.g. ones reading files or
+// channels) be composed with Zip/Concat without corrupting their state on
+// partial failure.
+func Safe[T any](s SliceIterator[T]) SliceIterator[T] {
+	return func(yield func(v T) bool) {
+		defer func() {
+			_ = recover()
+		}()
+		stopped := false
+		s(func(v T) bool {
+			if stopped {
+				return false
+			}
+			if !yield(v) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+	}
+}
+
+// runGuarded pulls from s via onValue, guaranteeing onValue's yield sees at
+// most one false return even if s panics or ignores the return value from
+// its own yield. Unlike Safe, runGuarded does not swallow a panic raised
+// inside s: it recovers it only so it can hand it back to the caller, who
+// is responsible for deciding what to do with it. A nil return means s ran
+// to completion (or was stopped normally by onValue) without panicking.
+func runGuarded[T any](s SliceIterator[T], onValue func(v T) bool) (panicVal any) {
+	defer func() {
+		panicVal = recover()
+	}()
+	stopped := false
+	s(func(v T) bool {
+		if stopped {
+			return false
+		}
+		if !onValue(v) {
+			stopped = true
+			return false
+		}
+		return true
+	})
+	return nil
+}
+
+// TryMap applies transform to every element of s and yields the result
+// alongside any error it returns. If transform returns a non-nil error, or
+// if s (or transform) panics, TryMap yields a single terminal error and
+// stops.
+func TryMap[T, V any](s SliceIterator[T], transform func(t T) (V, error)) TryIterator[V] {
+	return func(yield func(v V, err error) bool) {
+		consumerDone := false
+		panicVal := runGuarded(s, func(v T) bool {
+			out, err := transform(v)
+			if err != nil {
+				yield(out, err)
+				consumerDone = true
+				return false
+			}
+			if !yield(out, nil) {
+				consumerDone = true
+				return false
+			}
+			return true
+		})
+		if panicVal != nil && !consumerDone {
+			var zero V
+			yield(zero, fmt.Errorf("slice: TryMap: recovered from panic: %v", panicVal))
+		}
+	}
+}
+
+// TryFilter iterates over s and yields only the elements for which filter
+// returns true. If filter returns a non-nil error, or if s (or filter)
+// panics, TryFilter yields a single terminal error and stops.
+func TryFilter[T any](s SliceIterator[T], filter func(t T) (bool, error)) TryIterator[T] {
+	return func(yield func(v T, err error) bool) {
+		consumerDone := false
+		panicVal := runGuarded(s, func(v T) bool {
+			ok, err := filter(v)
+			if err != nil {
+				yield(v, err)
+				consumerDone = true
+				return false
+			}
+			if ok {
+				if !yield(v, nil) {
+					consumerDone = true
+					return false
+				}
+			}
+			return true
+		})
+		if panicVal != nil && !consumerDone {
+			var zero T
+			yield(zero, fmt.Errorf("slice: TryFilter: recovered from panic: %v", panicVal))
+		}
+	}
+}
+
+// Collect consumes the TryIterator and returns every successfully yielded
+// value along with the first error encountered, if any. Iteration stops at
+// the first error, so the returned slice never includes the element that
+// produced it.
+func (i TryIterator[T]) Collect() ([]T, error) {
+	var out []T
+	for v, err := range iter.Seq2[T, error](i) {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
@@ -0,0 +1,120 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// panickyIterator yields 0..n-1, panicking instead of yielding when it
+// reaches panicAt.
+func panickyIterator(n, panicAt int) SliceIterator[int] {
+	return func(yield func(v int) bool) {
+		for i := 0; i < n; i++ {
+			if i == panicAt {
+				panic(fmt.Sprintf("boom at %d", i))
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestSafeStopsAfterFirstNo(t *testing.T) {
+	misbehaving := SliceIterator[int](func(yield func(v int) bool) {
+		for i := 0; i < 5; i++ {
+			yield(i) // ignores the return value and keeps going regardless
+		}
+	})
+
+	var got []int
+	Safe(misbehaving)(func(v int) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+
+	assertSlicesEqual(t, []int{0, 1}, got, "Safe did not stop after first false")
+}
+
+func TestSafeRecoversPanic(t *testing.T) {
+	var got []int
+	Safe(panickyIterator(5, 2))(func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+
+	assertSlicesEqual(t, []int{0, 1}, got, "Safe should terminate silently after a panic")
+}
+
+func TestTryMapSuccess(t *testing.T) {
+	it := TryMap(NewIterator([]int{1, 2, 3}), func(v int) (int, error) { return v * v, nil })
+
+	vals, err := it.Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertSlicesEqual(t, []int{1, 4, 9}, vals, "TryMap success failed")
+}
+
+func TestTryMapTransformError(t *testing.T) {
+	boom := errors.New("boom")
+	it := TryMap(NewIterator([]int{1, 2, 3}), func(v int) (int, error) {
+		if v == 2 {
+			return 0, boom
+		}
+		return v, nil
+	})
+
+	vals, err := it.Collect()
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom error, got %v", err)
+	}
+	assertSlicesEqual(t, []int{1}, vals, "values before error mismatch")
+}
+
+func TestTryMapProducerPanic(t *testing.T) {
+	it := TryMap(panickyIterator(5, 2), func(v int) (int, error) { return v * 2, nil })
+
+	vals, err := it.Collect()
+	if err == nil {
+		t.Fatalf("expected an error from a panicking producer, got nil")
+	}
+	assertSlicesEqual(t, []int{0, 2}, vals, "values collected before the panic mismatch")
+}
+
+func TestTryFilterSuccess(t *testing.T) {
+	it := TryFilter(NewIterator([]int{1, 2, 3, 4}), func(v int) (bool, error) { return v%2 == 0, nil })
+
+	vals, err := it.Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertSlicesEqual(t, []int{2, 4}, vals, "TryFilter success failed")
+}
+
+func TestTryFilterPredicateError(t *testing.T) {
+	boom := errors.New("boom")
+	it := TryFilter(NewIterator([]int{1, 2, 3}), func(v int) (bool, error) {
+		if v == 2 {
+			return false, boom
+		}
+		return true, nil
+	})
+
+	vals, err := it.Collect()
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom error, got %v", err)
+	}
+	assertSlicesEqual(t, []int{1}, vals, "values before error mismatch")
+}
+
+func TestTryFilterProducerPanic(t *testing.T) {
+	it := TryFilter(panickyIterator(5, 3), func(v int) (bool, error) { return true, nil })
+
+	vals, err := it.Collect()
+	if err == nil {
+		t.Fatalf("expected an error from a panicking producer, got nil")
+	}
+	assertSlicesEqual(t, []int{0, 1, 2}, vals, "values collected before the panic mismatch")
+}